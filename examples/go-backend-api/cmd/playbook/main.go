@@ -0,0 +1,106 @@
+// Command playbook runs the HTTP user API.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"go-backend-api/pkg/auth"
+	"go-backend-api/pkg/config"
+	"go-backend-api/pkg/httpapi"
+	"go-backend-api/pkg/middleware"
+	"go-backend-api/pkg/users"
+)
+
+// initDB initializes the database connection and schema.
+func initDB(databaseURL string) (*sql.DB, error) {
+	db, err := sql.Open("postgres", databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// Configure connection pool
+	db.SetMaxOpenConns(25)
+	db.SetMaxIdleConns(5)
+	db.SetConnMaxLifetime(5 * time.Minute)
+
+	// Test connection
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	if _, err := db.Exec(users.Schema); err != nil {
+		return nil, fmt.Errorf("failed to create users schema: %w", err)
+	}
+	if _, err := db.Exec(auth.Schema); err != nil {
+		return nil, fmt.Errorf("failed to create auth schema: %w", err)
+	}
+	if _, err := db.Exec(auth.SessionSchema); err != nil {
+		return nil, fmt.Errorf("failed to create sessions schema: %w", err)
+	}
+
+	return db, nil
+}
+
+func main() {
+	cfg := config.Load()
+
+	db, err := initDB(cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("Database initialization failed: %v", err)
+	}
+	defer db.Close()
+
+	server := httpapi.NewServer(users.NewPostgresRepository(db, cfg.MaxListLimit), db)
+
+	sessions := auth.NewSessionStore(db, cfg.SessionTTL, cfg.SessionIdleTimeout, cfg.SessionReapInterval)
+
+	// Wrap every route in an Apache combined-format access log.
+	accessLog := middleware.AccessLog(os.Stdout, middleware.DefaultCombinedFormat)
+
+	httpServer := &http.Server{
+		Addr:         ":" + cfg.Port,
+		Handler:      accessLog(server),
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
+	}
+
+	// Handle graceful shutdown
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		sig := <-sigChan
+		log.Printf("Received signal: %v", sig)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if err := httpServer.Shutdown(ctx); err != nil {
+			log.Printf("Server shutdown error: %v", err)
+		}
+	}()
+
+	log.Printf("Starting server on %s", httpServer.Addr)
+	if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.Fatalf("Server error: %v", err)
+	}
+
+	// Stop the session reaper before the deferred db.Close() runs, so it
+	// never races a reap against a closing pool.
+	if err := sessions.Shutdown(); err != nil {
+		log.Printf("Session store shutdown error: %v", err)
+	}
+
+	log.Println("Server stopped")
+}
@@ -0,0 +1,203 @@
+// Package auth provides opaque bearer-token authentication backed by a
+// tokens table: user registration, login, and HTTP middleware that
+// resolves a token to its owning user.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+
+	"go-backend-api/pkg/middleware"
+)
+
+// User is the minimal identity resolved from a bearer token.
+type User struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type contextKey int
+
+const userContextKey contextKey = iota
+
+// UserFromContext returns the user injected by AuthMiddleware, if any.
+func UserFromContext(ctx context.Context) (User, bool) {
+	u, ok := ctx.Value(userContextKey).(User)
+	return u, ok
+}
+
+// Schema creates the tokens table if it does not already exist.
+const Schema = `
+CREATE TABLE IF NOT EXISTS tokens (
+	token TEXT PRIMARY KEY,
+	user_id INT REFERENCES users(id),
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+`
+
+// GenerateToken returns a new opaque, base64-encoded random token.
+func GenerateToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// AddUser creates a user and issues it a fresh token, returning the token
+// so callers (including tests) can authenticate as the new user.
+func AddUser(db *sql.DB, name, email string) (string, error) {
+	var userID int
+	err := db.QueryRow(
+		"INSERT INTO users (name, email) VALUES ($1, $2) RETURNING id",
+		name, email,
+	).Scan(&userID)
+	if err != nil {
+		return "", err
+	}
+	return issueToken(db, userID)
+}
+
+func issueToken(db *sql.DB, userID int) (string, error) {
+	token, err := GenerateToken()
+	if err != nil {
+		return "", err
+	}
+	if _, err := db.Exec(
+		"INSERT INTO tokens (token, user_id) VALUES ($1, $2)",
+		token, userID,
+	); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// userByToken resolves a bearer token to its owning user.
+func userByToken(db *sql.DB, token string) (User, error) {
+	var u User
+	err := db.QueryRow(
+		`SELECT users.id, users.name, users.email
+		 FROM tokens JOIN users ON users.id = tokens.user_id
+		 WHERE tokens.token = $1`,
+		token,
+	).Scan(&u.ID, &u.Name, &u.Email)
+	return u, err
+}
+
+// Middleware reads the Authorization: Bearer <token> header, resolves it
+// to a user, and injects that user into the request context. Requests
+// with a missing or invalid token are rejected with 401.
+func Middleware(db *sql.DB) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r)
+			if !ok {
+				http.Error(w, "Missing bearer token", http.StatusUnauthorized)
+				return
+			}
+
+			u, err := userByToken(db, token)
+			if err == sql.ErrNoRows {
+				http.Error(w, "Invalid token", http.StatusUnauthorized)
+				return
+			}
+			if err != nil {
+				http.Error(w, "Failed to resolve token", http.StatusInternalServerError)
+				log.Printf("Token lookup error: %v", err)
+				return
+			}
+
+			middleware.SetUser(w, u.Email)
+			ctx := context.WithValue(r.Context(), userContextKey, u)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	h := r.Header.Get("Authorization")
+	const prefix = "Bearer "
+	if !strings.HasPrefix(h, prefix) {
+		return "", false
+	}
+	token := strings.TrimPrefix(h, prefix)
+	if token == "" {
+		return "", false
+	}
+	return token, true
+}
+
+// RegisterHandler creates a new user and returns a token for it.
+func RegisterHandler(db *sql.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var input struct {
+			Name  string `json:"name"`
+			Email string `json:"email"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if input.Name == "" || input.Email == "" {
+			http.Error(w, "Name and email are required", http.StatusBadRequest)
+			return
+		}
+
+		token, err := AddUser(db, input.Name, input.Email)
+		if err != nil {
+			http.Error(w, "Failed to register user", http.StatusInternalServerError)
+			log.Printf("Register error: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]string{"token": token})
+	}
+}
+
+// LoginHandler issues a fresh token for an existing user, looked up by email.
+func LoginHandler(db *sql.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var input struct {
+			Email string `json:"email"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		if input.Email == "" {
+			http.Error(w, "Email is required", http.StatusBadRequest)
+			return
+		}
+
+		var userID int
+		err := db.QueryRow("SELECT id FROM users WHERE email = $1", input.Email).Scan(&userID)
+		if err == sql.ErrNoRows {
+			http.Error(w, "User not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			http.Error(w, "Failed to look up user", http.StatusInternalServerError)
+			log.Printf("Login lookup error: %v", err)
+			return
+		}
+
+		token, err := issueToken(db, userID)
+		if err != nil {
+			http.Error(w, "Failed to issue token", http.StatusInternalServerError)
+			log.Printf("Login issue error: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"token": token})
+	}
+}
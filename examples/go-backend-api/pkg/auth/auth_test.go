@@ -0,0 +1,90 @@
+package auth_test
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"go-backend-api/pkg/auth"
+)
+
+// setupTestDB creates an in-memory test database with the users and
+// tokens schema.
+func setupTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = db.Exec(`
+	CREATE TABLE users (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT NOT NULL,
+		email TEXT NOT NULL UNIQUE,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+	`)
+	require.NoError(t, err)
+
+	_, err = db.Exec(auth.Schema)
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestAddUser_ReturnsWorkingToken(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	token, err := auth.AddUser(db, "Alice", "alice@example.com")
+	require.NoError(t, err)
+	assert.NotEmpty(t, token)
+
+	req := httptest.NewRequest("GET", "/users/me", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+
+	var resolved auth.User
+	handler := auth.Middleware(db)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resolved, _ = auth.UserFromContext(r.Context())
+	}))
+	handler.ServeHTTP(w, req)
+
+	assert.Equal(t, "alice@example.com", resolved.Email)
+}
+
+func TestMiddleware_MissingToken(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	req := httptest.NewRequest("GET", "/users/me", nil)
+	w := httptest.NewRecorder()
+
+	handler := auth.Middleware(db)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler should not be reached without a token")
+	}))
+	handler.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestMiddleware_InvalidToken(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	req := httptest.NewRequest("GET", "/users/me", nil)
+	req.Header.Set("Authorization", "Bearer not-a-real-token")
+	w := httptest.NewRecorder()
+
+	handler := auth.Middleware(db)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler should not be reached with an invalid token")
+	}))
+	handler.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
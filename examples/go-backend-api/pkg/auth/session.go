@@ -0,0 +1,238 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"go-backend-api/pkg/middleware"
+)
+
+// SessionSchema creates the sessions table if it does not already exist.
+const SessionSchema = `
+CREATE TABLE IF NOT EXISTS sessions (
+	id TEXT PRIMARY KEY,
+	user_id INT,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	expires_at TIMESTAMP NOT NULL,
+	last_access TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+`
+
+// ErrSessionNotFound is returned when a session ID has no matching row,
+// or matches an expired one.
+var ErrSessionNotFound = errors.New("session not found")
+
+// Session is a single row of the sessions table.
+type Session struct {
+	ID         string
+	UserID     int
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastAccess time.Time
+}
+
+// SessionStore manages sessions in a sessions table, reaping expired
+// and idle rows in the background.
+type SessionStore struct {
+	db          *sql.DB
+	ttl         time.Duration
+	idleTimeout time.Duration
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewSessionStore creates a SessionStore and starts its background
+// reaper, which every reapEvery deletes sessions past ttl or idle
+// longer than idleTimeout. Call Shutdown to stop it.
+func NewSessionStore(db *sql.DB, ttl, idleTimeout, reapEvery time.Duration) *SessionStore {
+	s := &SessionStore{
+		db:          db,
+		ttl:         ttl,
+		idleTimeout: idleTimeout,
+		done:        make(chan struct{}),
+	}
+
+	s.wg.Add(1)
+	go s.reapLoop(reapEvery)
+
+	return s
+}
+
+// Create starts a new session for userID, valid for the store's ttl.
+func (s *SessionStore) Create(userID int) (Session, error) {
+	id, err := GenerateToken()
+	if err != nil {
+		return Session{}, err
+	}
+
+	now := time.Now()
+	sess := Session{
+		ID:         id,
+		UserID:     userID,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(s.ttl),
+		LastAccess: now,
+	}
+
+	_, err = s.db.Exec(
+		"INSERT INTO sessions (id, user_id, created_at, expires_at, last_access) VALUES ($1, $2, $3, $4, $5)",
+		sess.ID, sess.UserID, sess.CreatedAt, sess.ExpiresAt, sess.LastAccess,
+	)
+	if err != nil {
+		return Session{}, err
+	}
+	return sess, nil
+}
+
+// Get returns the session for id, or ErrSessionNotFound if it does not
+// exist or has expired.
+func (s *SessionStore) Get(id string) (Session, error) {
+	var sess Session
+	err := s.db.QueryRow(
+		"SELECT id, user_id, created_at, expires_at, last_access FROM sessions WHERE id = $1",
+		id,
+	).Scan(&sess.ID, &sess.UserID, &sess.CreatedAt, &sess.ExpiresAt, &sess.LastAccess)
+
+	if err == sql.ErrNoRows {
+		return Session{}, ErrSessionNotFound
+	}
+	if err != nil {
+		return Session{}, err
+	}
+	if time.Now().After(sess.ExpiresAt) {
+		return Session{}, ErrSessionNotFound
+	}
+	return sess, nil
+}
+
+// Touch updates a session's last_access to now, extending its idle
+// window. It returns ErrSessionNotFound if the session is missing or
+// already expired.
+func (s *SessionStore) Touch(id string) error {
+	now := time.Now()
+	result, err := s.db.Exec(
+		"UPDATE sessions SET last_access = $1 WHERE id = $2 AND expires_at > $1",
+		now, id,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrSessionNotFound
+	}
+	return nil
+}
+
+// Delete removes a session, e.g. on logout.
+func (s *SessionStore) Delete(id string) error {
+	_, err := s.db.Exec("DELETE FROM sessions WHERE id = $1", id)
+	return err
+}
+
+// Shutdown stops the background reaper and waits for it to exit.
+func (s *SessionStore) Shutdown() error {
+	close(s.done)
+	s.wg.Wait()
+	return nil
+}
+
+func (s *SessionStore) reapLoop(reapEvery time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(reapEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.reap()
+		}
+	}
+}
+
+// reap deletes sessions that are past their ttl or have been idle
+// longer than idleTimeout.
+func (s *SessionStore) reap() {
+	now := time.Now()
+	idleCutoff := now.Add(-s.idleTimeout)
+
+	if _, err := s.db.Exec(
+		"DELETE FROM sessions WHERE expires_at < $1 OR last_access < $2",
+		now, idleCutoff,
+	); err != nil {
+		log.Printf("Session reap error: %v", err)
+	}
+}
+
+// SessionMiddleware reads the session_id cookie, touches and resolves
+// it to a user, and injects that user into the request context. A
+// missing, invalid, or expired session clears the cookie and responds
+// with 401.
+func SessionMiddleware(store *SessionStore, db *sql.DB) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cookie, err := r.Cookie("session_id")
+			if err != nil {
+				clearSessionCookie(w)
+				http.Error(w, "Missing session", http.StatusUnauthorized)
+				return
+			}
+
+			sess, err := store.Get(cookie.Value)
+			if errors.Is(err, ErrSessionNotFound) {
+				clearSessionCookie(w)
+				http.Error(w, "Invalid session", http.StatusUnauthorized)
+				return
+			}
+			if err != nil {
+				http.Error(w, "Failed to resolve session", http.StatusInternalServerError)
+				log.Printf("Session lookup error: %v", err)
+				return
+			}
+
+			if err := store.Touch(sess.ID); err != nil {
+				log.Printf("Session touch error: %v", err)
+			}
+
+			u, err := userByID(db, sess.UserID)
+			if err != nil {
+				clearSessionCookie(w)
+				http.Error(w, "Failed to resolve user", http.StatusInternalServerError)
+				log.Printf("Session user lookup error: %v", err)
+				return
+			}
+
+			middleware.SetUser(w, u.Email)
+			ctx := context.WithValue(r.Context(), userContextKey, u)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func clearSessionCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:   "session_id",
+		Value:  "",
+		Path:   "/",
+		MaxAge: -1,
+	})
+}
+
+func userByID(db *sql.DB, id int) (User, error) {
+	var u User
+	err := db.QueryRow("SELECT id, name, email FROM users WHERE id = $1", id).Scan(&u.ID, &u.Name, &u.Email)
+	return u, err
+}
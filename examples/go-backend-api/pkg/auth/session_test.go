@@ -0,0 +1,89 @@
+package auth_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-backend-api/pkg/auth"
+)
+
+func setupSessionTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db := setupTestDB(t)
+	_, err := db.Exec(auth.SessionSchema)
+	require.NoError(t, err)
+	return db
+}
+
+func TestSessionStore_CreateGetTouch(t *testing.T) {
+	db := setupSessionTestDB(t)
+	defer db.Close()
+
+	store := auth.NewSessionStore(db, time.Hour, time.Hour, time.Hour)
+	defer store.Shutdown()
+
+	_, err := auth.AddUser(db, "Alice", "alice@example.com")
+	require.NoError(t, err)
+
+	sess, err := store.Create(1)
+	require.NoError(t, err)
+	require.NotEmpty(t, sess.ID)
+
+	got, err := store.Get(sess.ID)
+	require.NoError(t, err)
+	assert.Equal(t, sess.ID, got.ID)
+
+	require.NoError(t, store.Touch(sess.ID))
+}
+
+func TestSessionStore_GetExpired(t *testing.T) {
+	db := setupSessionTestDB(t)
+	defer db.Close()
+
+	store := auth.NewSessionStore(db, -time.Minute, time.Hour, time.Hour)
+	defer store.Shutdown()
+
+	sess, err := store.Create(1)
+	require.NoError(t, err)
+
+	_, err = store.Get(sess.ID)
+	assert.ErrorIs(t, err, auth.ErrSessionNotFound)
+}
+
+func TestSessionStore_TouchUnknownSession(t *testing.T) {
+	db := setupSessionTestDB(t)
+	defer db.Close()
+
+	store := auth.NewSessionStore(db, time.Hour, time.Hour, time.Hour)
+	defer store.Shutdown()
+
+	assert.ErrorIs(t, store.Touch("does-not-exist"), auth.ErrSessionNotFound)
+}
+
+func TestSessionStore_Delete(t *testing.T) {
+	db := setupSessionTestDB(t)
+	defer db.Close()
+
+	store := auth.NewSessionStore(db, time.Hour, time.Hour, time.Hour)
+	defer store.Shutdown()
+
+	sess, err := store.Create(1)
+	require.NoError(t, err)
+	require.NoError(t, store.Delete(sess.ID))
+
+	_, err = store.Get(sess.ID)
+	assert.ErrorIs(t, err, auth.ErrSessionNotFound)
+}
+
+func TestSessionStore_ShutdownStopsReaper(t *testing.T) {
+	db := setupSessionTestDB(t)
+	defer db.Close()
+
+	store := auth.NewSessionStore(db, time.Hour, time.Hour, time.Millisecond)
+	require.NoError(t, store.Shutdown())
+}
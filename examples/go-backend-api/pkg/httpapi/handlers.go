@@ -0,0 +1,152 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"go-backend-api/pkg/auth"
+	"go-backend-api/pkg/users"
+)
+
+// ListUsers returns a page of users, filtered and sorted per the
+// ?limit=, ?after=, ?sort=, ?order=, and ?q= query parameters.
+func (s *Server) ListUsers(w http.ResponseWriter, r *http.Request) {
+	params, err := parseListParams(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	page, err := s.repo.List(r.Context(), params)
+	if errors.Is(err, users.ErrInvalidListParams) || errors.Is(err, users.ErrInvalidCursor) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Failed to query users", http.StatusInternalServerError)
+		log.Printf("List error: %v", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(page)
+}
+
+// parseListParams builds users.ListParams from request query parameters,
+// rejecting a malformed ?limit= or ?after= with an error.
+func parseListParams(q url.Values) (users.ListParams, error) {
+	params := users.ListParams{
+		Sort:  users.SortField(q.Get("sort")),
+		Order: users.SortOrder(q.Get("order")),
+		Query: q.Get("q"),
+	}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit <= 0 {
+			return users.ListParams{}, fmt.Errorf("invalid limit")
+		}
+		params.Limit = limit
+	}
+
+	if v := q.Get("after"); v != "" {
+		cursor, err := users.DecodeCursor(v)
+		if err != nil {
+			return users.ListParams{}, err
+		}
+		params.After = &cursor
+	}
+
+	return params, params.Validate()
+}
+
+// GetUser returns a single user by ID.
+func (s *Server) GetUser(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		return
+	}
+
+	u, err := s.repo.Get(r.Context(), id)
+	if errors.Is(err, users.ErrNotFound) {
+		http.Error(w, "User not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Failed to query user", http.StatusInternalServerError)
+		log.Printf("Get error: %v", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(u)
+}
+
+// CreateUser creates a new user.
+func (s *Server) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var input struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if input.Name == "" || input.Email == "" {
+		http.Error(w, "Name and email are required", http.StatusBadRequest)
+		return
+	}
+
+	u, err := s.repo.Create(r.Context(), input.Name, input.Email)
+	if err != nil {
+		http.Error(w, "Failed to create user", http.StatusInternalServerError)
+		log.Printf("Create error: %v", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(u)
+}
+
+// DeleteUser deletes a user by ID.
+func (s *Server) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		return
+	}
+
+	err = s.repo.Delete(r.Context(), id)
+	if errors.Is(err, users.ErrNotFound) {
+		http.Error(w, "User not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Failed to delete user", http.StatusInternalServerError)
+		log.Printf("Delete error: %v", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Me returns the user resolved from the caller's bearer token.
+func (s *Server) Me(w http.ResponseWriter, r *http.Request) {
+	u, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(users.User{ID: u.ID, Name: u.Name, Email: u.Email})
+}
@@ -0,0 +1,196 @@
+package httpapi_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"go-backend-api/pkg/auth"
+	"go-backend-api/pkg/httpapi"
+	"go-backend-api/pkg/users"
+)
+
+// setupTestServer returns a Server backed by an in-memory Repository,
+// plus the SQLite db used for auth (registration/login/middleware).
+func setupTestServer(t *testing.T) (*httpapi.Server, *sql.DB) {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec(`
+	CREATE TABLE users (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT NOT NULL,
+		email TEXT NOT NULL UNIQUE,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+	`)
+	require.NoError(t, err)
+	_, err = db.Exec(auth.Schema)
+	require.NoError(t, err)
+
+	return httpapi.NewServer(users.NewMemoryRepository(users.DefaultListLimit), db), db
+}
+
+func TestListUsers_Success(t *testing.T) {
+	s, _ := setupTestServer(t)
+
+	req := httptest.NewRequest("GET", "/users", nil)
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestListUsers_RejectsMalformedCursor(t *testing.T) {
+	s, _ := setupTestServer(t)
+
+	req := httptest.NewRequest("GET", "/users?after=not-valid-base64!!", nil)
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestListUsers_RejectsInvalidSort(t *testing.T) {
+	s, _ := setupTestServer(t)
+
+	req := httptest.NewRequest("GET", "/users?sort=bogus", nil)
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetUser_Success(t *testing.T) {
+	s, db := setupTestServer(t)
+	token := mustToken(t, db)
+
+	created := mustCreate(t, s, token, "Alice", "alice@example.com")
+
+	req := httptest.NewRequest("GET", "/users/"+strconv.Itoa(created.ID), nil)
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var u users.User
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&u))
+	assert.Equal(t, "Alice", u.Name)
+}
+
+func TestGetUser_NotFound(t *testing.T) {
+	s, _ := setupTestServer(t)
+
+	req := httptest.NewRequest("GET", "/users/999", nil)
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestCreateUser_Success(t *testing.T) {
+	s, db := setupTestServer(t)
+	token := mustToken(t, db)
+
+	body := bytes.NewBufferString(`{"name":"Alice","email":"alice@example.com"}`)
+	req := httptest.NewRequest("POST", "/users", body)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestCreateUser_Unauthenticated(t *testing.T) {
+	s, _ := setupTestServer(t)
+
+	body := bytes.NewBufferString(`{"name":"Alice","email":"alice@example.com"}`)
+	req := httptest.NewRequest("POST", "/users", body)
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestCreateUser_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "missing name", input: `{"email":"alice@example.com"}`},
+		{name: "missing email", input: `{"name":"Alice"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, db := setupTestServer(t)
+			token := mustToken(t, db)
+
+			req := httptest.NewRequest("POST", "/users", bytes.NewBufferString(tt.input))
+			req.Header.Set("Authorization", "Bearer "+token)
+			w := httptest.NewRecorder()
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func TestDeleteUser_Success(t *testing.T) {
+	s, db := setupTestServer(t)
+	token := mustToken(t, db)
+
+	created := mustCreate(t, s, token, "Alice", "alice@example.com")
+
+	req := httptest.NewRequest("DELETE", "/users/"+strconv.Itoa(created.ID), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestDeleteUser_NotFound(t *testing.T) {
+	s, db := setupTestServer(t)
+	token := mustToken(t, db)
+
+	req := httptest.NewRequest("DELETE", "/users/999", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func mustToken(t *testing.T, db *sql.DB) string {
+	t.Helper()
+	token, err := auth.AddUser(db, "Dana", "dana@example.com")
+	require.NoError(t, err)
+	return token
+}
+
+func mustCreate(t *testing.T, s *httpapi.Server, token, name, email string) users.User {
+	t.Helper()
+
+	body := bytes.NewBufferString(`{"name":"` + name + `","email":"` + email + `"}`)
+	req := httptest.NewRequest("POST", "/users", body)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var u users.User
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&u))
+	return u
+}
@@ -0,0 +1,137 @@
+//go:build integration
+
+package httpapi
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-backend-api/pkg/auth"
+	pgtest "go-backend-api/pkg/test"
+	"go-backend-api/pkg/users"
+	"go-backend-api/scripts/postgres/fixtures"
+)
+
+// setupIntegrationServer loads the "basic_users" fixture (Alice, Bob)
+// into a real Postgres database and returns a Server backed by it.
+func setupIntegrationServer(t *testing.T) *Server {
+	t.Helper()
+
+	db, cleanup := pgtest.NewPostgresDB(t)
+	t.Cleanup(cleanup)
+
+	require.NoError(t, fixtures.Load(db, "basic_users"))
+
+	return NewServer(users.NewPostgresRepository(db, users.DefaultListLimit), db)
+}
+
+func Test_int_ListUsers_Success(t *testing.T) {
+	s := setupIntegrationServer(t)
+
+	req := httptest.NewRequest("GET", "/users", nil)
+	w := httptest.NewRecorder()
+	s.mux.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var page users.Page
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&page))
+	require.Len(t, page.Data, 2)
+	assert.Equal(t, "Alice", page.Data[0].Name)
+	assert.Equal(t, "Bob", page.Data[1].Name)
+	assert.False(t, page.HasMore)
+}
+
+func Test_int_GetUser_Success(t *testing.T) {
+	s := setupIntegrationServer(t)
+
+	req := httptest.NewRequest("GET", "/users/1", nil)
+	w := httptest.NewRecorder()
+	s.mux.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var u users.User
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&u))
+	assert.Equal(t, "Alice", u.Name)
+}
+
+func Test_int_GetUser_NotFound(t *testing.T) {
+	s := setupIntegrationServer(t)
+
+	req := httptest.NewRequest("GET", "/users/999", nil)
+	w := httptest.NewRecorder()
+	s.mux.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func Test_int_CreateUser_Success(t *testing.T) {
+	s := setupIntegrationServer(t)
+	token := mustAuthToken(t, s.db)
+
+	body := bytes.NewBufferString(`{"name":"Carol","email":"carol@example.com"}`)
+	req := httptest.NewRequest("POST", "/users", body)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	s.mux.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var u users.User
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&u))
+	assert.Equal(t, "Carol", u.Name)
+}
+
+func Test_int_CreateUser_InvalidInput(t *testing.T) {
+	s := setupIntegrationServer(t)
+	token := mustAuthToken(t, s.db)
+
+	body := bytes.NewBufferString(`{"email":"carol@example.com"}`)
+	req := httptest.NewRequest("POST", "/users", body)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	s.mux.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_int_DeleteUser_Success(t *testing.T) {
+	s := setupIntegrationServer(t)
+	token := mustAuthToken(t, s.db)
+
+	req := httptest.NewRequest("DELETE", "/users/1", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	s.mux.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func Test_int_DeleteUser_NotFound(t *testing.T) {
+	s := setupIntegrationServer(t)
+	token := mustAuthToken(t, s.db)
+
+	req := httptest.NewRequest("DELETE", "/users/999", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	s.mux.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// mustAuthToken registers a fresh user and returns its bearer token.
+func mustAuthToken(t *testing.T, db *sql.DB) string {
+	t.Helper()
+	token, err := auth.AddUser(db, "Dana", "dana@example.com")
+	require.NoError(t, err)
+	return token
+}
@@ -0,0 +1,45 @@
+// Package httpapi wires the HTTP routes and handlers for the user API.
+package httpapi
+
+import (
+	"database/sql"
+	"net/http"
+
+	"go-backend-api/pkg/auth"
+	"go-backend-api/pkg/users"
+)
+
+// Server holds the API's dependencies and routes.
+type Server struct {
+	repo users.Repository
+	db   *sql.DB // used by the auth subsystem only
+	mux  *http.ServeMux
+}
+
+// NewServer creates a Server backed by repo for user data and db for
+// authentication (token issuance and lookup).
+func NewServer(repo users.Repository, db *sql.DB) *Server {
+	s := &Server{repo: repo, db: db, mux: http.NewServeMux()}
+	s.setupRoutes()
+	return s
+}
+
+// ServeHTTP makes Server an http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// setupRoutes registers all HTTP handlers. Mutating user routes, plus
+// /users/me, require a valid bearer token.
+func (s *Server) setupRoutes() {
+	requireAuth := auth.Middleware(s.db)
+
+	s.mux.HandleFunc("GET /users", s.ListUsers)
+	s.mux.HandleFunc("GET /users/{id}", s.GetUser)
+	s.mux.Handle("POST /users", requireAuth(http.HandlerFunc(s.CreateUser)))
+	s.mux.Handle("DELETE /users/{id}", requireAuth(http.HandlerFunc(s.DeleteUser)))
+	s.mux.Handle("GET /users/me", requireAuth(http.HandlerFunc(s.Me)))
+
+	s.mux.HandleFunc("POST /auth/register", auth.RegisterHandler(s.db))
+	s.mux.HandleFunc("POST /auth/login", auth.LoginHandler(s.db))
+}
@@ -0,0 +1,195 @@
+// Package middleware provides HTTP middleware shared across the API,
+// starting with an Apache-style access log.
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultCombinedFormat mirrors Apache's "combined" log format.
+const DefaultCombinedFormat = `%h %l %u %t "%r" %>s %b "%{Referer}i" "%{User-Agent}i"`
+
+// entry carries everything a directive needs to render its piece of the
+// access log line for one request.
+type entry struct {
+	req    *http.Request
+	start  time.Time
+	status int
+	bytes  int
+	user   string
+}
+
+// directive renders one piece of a log line for a single request.
+type directive func(e *entry) string
+
+// AccessLog returns middleware that writes one access log line per
+// request to w, formatted per format. format supports a subset of
+// Apache's mod_log_config directives: %h, %l, %u, %t, %r, %>s, %b,
+// %{Header}i, and %D (elapsed microseconds). Unrecognized directives
+// are emitted as "-".
+func AccessLog(w io.Writer, format string) func(http.Handler) http.Handler {
+	render := parseFormat(format)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			sw := &statusWriter{ResponseWriter: rw, status: http.StatusOK}
+			start := time.Now()
+
+			next.ServeHTTP(sw, r)
+
+			user := sw.user
+			if user == "" {
+				user = "-"
+			}
+
+			e := &entry{req: r, start: start, status: sw.status, bytes: sw.bytes, user: user}
+			fmt.Fprintln(w, render(e))
+		})
+	}
+}
+
+// parseFormat parses format once into a function that renders a
+// complete log line for a given entry, so the format string itself is
+// not re-parsed on every request.
+func parseFormat(format string) func(*entry) string {
+	var directives []directive
+
+	i := 0
+	for i < len(format) {
+		if format[i] != '%' {
+			j := i
+			for j < len(format) && format[j] != '%' {
+				j++
+			}
+			lit := format[i:j]
+			directives = append(directives, func(*entry) string { return lit })
+			i = j
+			continue
+		}
+
+		i++ // consume '%'
+		if i >= len(format) {
+			directives = append(directives, func(*entry) string { return "%" })
+			break
+		}
+
+		if format[i] == '>' {
+			i++ // %>s: "final" status; we have only one, so treat like %s
+		}
+
+		if format[i] == '{' {
+			end := strings.IndexByte(format[i:], '}')
+			if end == -1 {
+				directives = append(directives, func(*entry) string { return "-" })
+				break
+			}
+			header := format[i+1 : i+end]
+			i += end + 1 // skip past '{Header}'
+			if i >= len(format) {
+				directives = append(directives, func(*entry) string { return "-" })
+				break
+			}
+			verb := format[i]
+			i++
+			directives = append(directives, headerDirective(verb, header))
+			continue
+		}
+
+		verb := format[i]
+		i++
+		directives = append(directives, directiveFor(verb))
+	}
+
+	return func(e *entry) string {
+		var b strings.Builder
+		for _, d := range directives {
+			b.WriteString(d(e))
+		}
+		return b.String()
+	}
+}
+
+func directiveFor(verb byte) directive {
+	switch verb {
+	case 'h':
+		return func(e *entry) string { return remoteHost(e.req) }
+	case 'l':
+		return func(e *entry) string { return "-" }
+	case 'u':
+		return func(e *entry) string { return e.user }
+	case 't':
+		return func(e *entry) string { return "[" + e.start.Format("02/Jan/2006:15:04:05 -0700") + "]" }
+	case 'r':
+		return func(e *entry) string { return fmt.Sprintf("%s %s %s", e.req.Method, e.req.RequestURI, e.req.Proto) }
+	case 's':
+		return func(e *entry) string { return strconv.Itoa(e.status) }
+	case 'b':
+		return func(e *entry) string {
+			if e.bytes == 0 {
+				return "-"
+			}
+			return strconv.Itoa(e.bytes)
+		}
+	case 'D':
+		return func(e *entry) string { return strconv.FormatInt(time.Since(e.start).Microseconds(), 10) }
+	default:
+		return func(*entry) string { return "-" }
+	}
+}
+
+func headerDirective(verb byte, header string) directive {
+	if verb != 'i' {
+		return func(*entry) string { return "-" }
+	}
+	return func(e *entry) string {
+		if v := e.req.Header.Get(header); v != "" {
+			return v
+		}
+		return "-"
+	}
+}
+
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// SetUser records the authenticated user for the access log line
+// covering the current request. Auth middleware running inside
+// AccessLog (i.e. on requests it wraps) should call this once it has
+// resolved a user, since AccessLog itself runs before any deeper
+// middleware injects one into the request context.
+func SetUser(w http.ResponseWriter, user string) {
+	if sw, ok := w.(*statusWriter); ok {
+		sw.user = user
+	}
+}
+
+// statusWriter wraps http.ResponseWriter to record the status code,
+// byte count, and authenticated user (if any) for one request.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+	user   string
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
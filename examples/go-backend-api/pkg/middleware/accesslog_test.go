@@ -0,0 +1,110 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-backend-api/pkg/middleware"
+)
+
+func TestAccessLog_CombinedFormat(t *testing.T) {
+	var buf strings.Builder
+
+	handler := middleware.AccessLog(&buf, middleware.DefaultCombinedFormat)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte("hello"))
+		}),
+	)
+
+	req := httptest.NewRequest("POST", "/users", nil)
+	req.RemoteAddr = "203.0.113.5:54321"
+	req.Header.Set("User-Agent", "go-test-agent")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	line := buf.String()
+	assert.Contains(t, line, "203.0.113.5")
+	assert.Contains(t, line, `"POST /users HTTP/1.1"`)
+	assert.Contains(t, line, "201")
+	assert.Contains(t, line, "5")
+	assert.Contains(t, line, "go-test-agent")
+	require.True(t, strings.HasSuffix(line, "\n"))
+}
+
+func TestAccessLog_MicrosecondsDirective(t *testing.T) {
+	var buf strings.Builder
+
+	handler := middleware.AccessLog(&buf, "%D")(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+	)
+
+	req := httptest.NewRequest("GET", "/users", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	assert.NotEmpty(t, strings.TrimSpace(buf.String()))
+}
+
+func TestAccessLog_UserDirectiveDefaultsToDash(t *testing.T) {
+	var buf strings.Builder
+
+	handler := middleware.AccessLog(&buf, "%u")(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+	)
+
+	req := httptest.NewRequest("GET", "/users", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	assert.Equal(t, "-\n", buf.String())
+}
+
+func TestAccessLog_UserDirectiveReflectsSetUser(t *testing.T) {
+	var buf strings.Builder
+
+	handler := middleware.AccessLog(&buf, "%u")(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			middleware.SetUser(w, "dana@example.com")
+		}),
+	)
+
+	req := httptest.NewRequest("GET", "/users", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	assert.Equal(t, "dana@example.com\n", buf.String())
+}
+
+func TestAccessLog_UnterminatedHeaderDirectiveAtEndOfFormat(t *testing.T) {
+	var buf strings.Builder
+
+	handler := middleware.AccessLog(&buf, "%{X-Request-Id}")(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+	)
+
+	req := httptest.NewRequest("GET", "/users", nil)
+	w := httptest.NewRecorder()
+
+	assert.NotPanics(t, func() { handler.ServeHTTP(w, req) })
+	assert.Equal(t, "-\n", buf.String())
+}
+
+func TestAccessLog_DefaultStatusIsOKWhenUnset(t *testing.T) {
+	var buf strings.Builder
+
+	handler := middleware.AccessLog(&buf, "%>s")(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+	)
+
+	req := httptest.NewRequest("GET", "/users", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	assert.Equal(t, "200\n", buf.String())
+}
@@ -0,0 +1,96 @@
+package users
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// SortField is a column ListParams may sort and page by.
+type SortField string
+
+// Supported sort fields.
+const (
+	SortByID        SortField = "id"
+	SortByName      SortField = "name"
+	SortByCreatedAt SortField = "created_at"
+)
+
+// SortOrder is the direction a list is sorted in.
+type SortOrder string
+
+// Supported sort orders.
+const (
+	OrderAsc  SortOrder = "asc"
+	OrderDesc SortOrder = "desc"
+)
+
+// DefaultListLimit is used when a caller does not specify ?limit=.
+const DefaultListLimit = 20
+
+// ErrInvalidListParams is returned when Sort or Order is not recognized.
+var ErrInvalidListParams = errors.New("invalid list params")
+
+// ErrInvalidCursor is returned when a cursor string cannot be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor identifies the last row seen on the previous page, so the next
+// page can resume with a keyset WHERE clause instead of OFFSET.
+type Cursor struct {
+	SortField SortField `json:"sort_field"`
+	LastValue string    `json:"last_value"`
+	LastID    int       `json:"last_id"`
+}
+
+// EncodeCursor base64-encodes c as opaque page-token text.
+func EncodeCursor(c Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor reverses EncodeCursor, returning ErrInvalidCursor if s is
+// malformed.
+func DecodeCursor(s string) (Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// ListParams controls pagination, filtering, and sorting for
+// Repository.List.
+type ListParams struct {
+	Limit int
+	After *Cursor
+	Sort  SortField
+	Order SortOrder
+	Query string // matched against name/email as a case-insensitive substring
+}
+
+// Validate rejects an unrecognized Sort or Order. Zero values mean
+// "use the default" and are accepted.
+func (p ListParams) Validate() error {
+	switch p.Sort {
+	case "", SortByID, SortByName, SortByCreatedAt:
+	default:
+		return ErrInvalidListParams
+	}
+	switch p.Order {
+	case "", OrderAsc, OrderDesc:
+	default:
+		return ErrInvalidListParams
+	}
+	return nil
+}
+
+// Page is one page of a List result.
+type Page struct {
+	Data       []User `json:"data"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+}
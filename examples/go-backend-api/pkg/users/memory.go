@@ -0,0 +1,188 @@
+package users
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MemoryRepository is an in-memory Repository used by fast unit tests
+// so handlers can be exercised without a real database.
+//
+// It has no created_at column, so SortByCreatedAt falls back to
+// insertion order (equivalent to ascending ID).
+type MemoryRepository struct {
+	mu           sync.Mutex
+	nextID       int
+	users        map[int]User
+	maxListLimit int
+}
+
+// NewMemoryRepository creates an empty MemoryRepository. maxListLimit
+// caps the page size List will ever return, regardless of the
+// requested ListParams.Limit.
+func NewMemoryRepository(maxListLimit int) *MemoryRepository {
+	return &MemoryRepository{nextID: 1, users: make(map[int]User), maxListLimit: maxListLimit}
+}
+
+var _ Repository = (*MemoryRepository)(nil)
+
+func (r *MemoryRepository) List(ctx context.Context, p ListParams) (Page, error) {
+	if err := p.Validate(); err != nil {
+		return Page{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	limit := p.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	if limit > r.maxListLimit {
+		limit = r.maxListLimit
+	}
+
+	sortField := p.Sort
+	if sortField == "" {
+		sortField = SortByID
+	}
+	order := p.Order
+	if order == "" {
+		order = OrderAsc
+	}
+
+	all := make([]User, 0, len(r.users))
+	for _, u := range r.users {
+		all = append(all, u)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		cmp := sortCompare(sortField, all[i], all[j])
+		if order == OrderDesc {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+
+	var matching []User
+	for _, u := range all {
+		if p.Query != "" && !matchesQuery(u, p.Query) {
+			continue
+		}
+		if p.After != nil && !pastCursor(sortField, order, u, *p.After) {
+			continue
+		}
+		matching = append(matching, u)
+	}
+
+	page := Page{Data: matching}
+	if len(matching) > limit {
+		page.Data = matching[:limit]
+		page.HasMore = true
+		last := page.Data[limit-1]
+		page.NextCursor = EncodeCursor(Cursor{
+			SortField: sortField,
+			LastValue: sortValue(sortField, last),
+			LastID:    last.ID,
+		})
+	}
+	return page, nil
+}
+
+func matchesQuery(u User, q string) bool {
+	q = strings.ToLower(q)
+	return strings.Contains(strings.ToLower(u.Name), q) || strings.Contains(strings.ToLower(u.Email), q)
+}
+
+// pastCursor reports whether u sorts strictly after the row identified
+// by cur, given sortField/order — i.e. whether u belongs on the next page.
+func pastCursor(sortField SortField, order SortOrder, u User, cur Cursor) bool {
+	cmp := compareToCursor(sortField, u, cur)
+	if order == OrderDesc {
+		return cmp < 0
+	}
+	return cmp > 0
+}
+
+// sortCompare orders a and b by (sortField, id), matching the composite
+// keyset comparison the Postgres repository expresses in SQL. IDs
+// compare numerically, never as strings, so ID 11 doesn't sort
+// before ID 9.
+func sortCompare(sortField SortField, a, b User) int {
+	if sortField == SortByName && a.Name != b.Name {
+		if a.Name < b.Name {
+			return -1
+		}
+		return 1
+	}
+	switch {
+	case a.ID == b.ID:
+		return 0
+	case a.ID < b.ID:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// compareToCursor compares u's (sortField, id) tuple to the one encoded
+// in cur.
+func compareToCursor(sortField SortField, u User, cur Cursor) int {
+	if sortField == SortByName && u.Name != cur.LastValue {
+		if u.Name < cur.LastValue {
+			return -1
+		}
+		return 1
+	}
+	switch {
+	case u.ID == cur.LastID:
+		return 0
+	case u.ID < cur.LastID:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func sortValue(sortField SortField, u User) string {
+	switch sortField {
+	case SortByName:
+		return u.Name
+	default: // SortByID, SortByCreatedAt (no created_at column to sort by)
+		return strconv.Itoa(u.ID)
+	}
+}
+
+func (r *MemoryRepository) Get(ctx context.Context, id int) (User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, ok := r.users[id]
+	if !ok {
+		return User{}, ErrNotFound
+	}
+	return u, nil
+}
+
+func (r *MemoryRepository) Create(ctx context.Context, name, email string) (User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u := User{ID: r.nextID, Name: name, Email: email}
+	r.users[u.ID] = u
+	r.nextID++
+	return u, nil
+}
+
+func (r *MemoryRepository) Delete(ctx context.Context, id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return ErrNotFound
+	}
+	delete(r.users, id)
+	return nil
+}
@@ -0,0 +1,201 @@
+package users
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schema creates the users table if it does not already exist.
+const Schema = `
+CREATE TABLE IF NOT EXISTS users (
+	id SERIAL PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	email VARCHAR(255) NOT NULL UNIQUE,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+`
+
+// PostgresRepository is a Repository backed by a Postgres users table.
+type PostgresRepository struct {
+	db           *sql.DB
+	maxListLimit int
+}
+
+// NewPostgresRepository creates a Repository backed by db. maxListLimit
+// caps the page size List will ever return, regardless of the
+// requested ListParams.Limit.
+func NewPostgresRepository(db *sql.DB, maxListLimit int) *PostgresRepository {
+	return &PostgresRepository{db: db, maxListLimit: maxListLimit}
+}
+
+var _ Repository = (*PostgresRepository)(nil)
+
+func (r *PostgresRepository) List(ctx context.Context, p ListParams) (Page, error) {
+	if err := p.Validate(); err != nil {
+		return Page{}, err
+	}
+
+	limit := p.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	if limit > r.maxListLimit {
+		limit = r.maxListLimit
+	}
+
+	sortCol := string(p.Sort)
+	if sortCol == "" {
+		sortCol = string(SortByID)
+	}
+	order := p.Order
+	if order == "" {
+		order = OrderAsc
+	}
+	cmp := ">"
+	orderSQL := "ASC"
+	if order == OrderDesc {
+		cmp = "<"
+		orderSQL = "DESC"
+	}
+
+	var where []string
+	var args []any
+
+	if p.Query != "" {
+		args = append(args, "%"+p.Query+"%")
+		where = append(where, fmt.Sprintf("(name ILIKE $%d OR email ILIKE $%d)", len(args), len(args)))
+	}
+
+	if p.After != nil {
+		val, err := cursorValue(*p.After)
+		if err != nil {
+			return Page{}, ErrInvalidCursor
+		}
+		args = append(args, val)
+		sortArg := len(args)
+		args = append(args, p.After.LastID)
+		idArg := len(args)
+		where = append(where, fmt.Sprintf("(%s, id) %s ($%d, $%d)", sortCol, cmp, sortArg, idArg))
+	}
+
+	query := "SELECT id, name, email, created_at FROM users"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY %s %s, id %s", sortCol, orderSQL, orderSQL)
+	args = append(args, limit+1)
+	query += fmt.Sprintf(" LIMIT $%d", len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return Page{}, err
+	}
+	defer rows.Close()
+
+	var (
+		out        []User
+		createdAts []time.Time
+	)
+	for rows.Next() {
+		var (
+			u         User
+			createdAt time.Time
+		)
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &createdAt); err != nil {
+			return Page{}, err
+		}
+		out = append(out, u)
+		createdAts = append(createdAts, createdAt)
+	}
+	if err := rows.Err(); err != nil {
+		return Page{}, err
+	}
+
+	page := Page{Data: out}
+	if len(out) > limit {
+		page.Data = out[:limit]
+		page.HasMore = true
+		last := page.Data[limit-1]
+		page.NextCursor = EncodeCursor(Cursor{
+			SortField: SortField(sortCol),
+			LastValue: cursorValueString(SortField(sortCol), last, createdAts[limit-1]),
+			LastID:    last.ID,
+		})
+	}
+
+	return page, nil
+}
+
+// cursorValue converts a cursor's string-encoded LastValue back into the
+// Go type the sort field's column holds, for use as a query parameter.
+func cursorValue(c Cursor) (any, error) {
+	switch c.SortField {
+	case SortByID:
+		return strconv.Atoi(c.LastValue)
+	case SortByCreatedAt:
+		return time.Parse(time.RFC3339Nano, c.LastValue)
+	default:
+		return c.LastValue, nil
+	}
+}
+
+// cursorValueString renders the value a row holds for sortCol as the
+// string representation stored in a Cursor.
+func cursorValueString(sortCol SortField, u User, createdAt time.Time) string {
+	switch sortCol {
+	case SortByID:
+		return strconv.Itoa(u.ID)
+	case SortByCreatedAt:
+		return createdAt.Format(time.RFC3339Nano)
+	default:
+		return u.Name
+	}
+}
+
+func (r *PostgresRepository) Get(ctx context.Context, id int) (User, error) {
+	var u User
+	err := r.db.QueryRowContext(ctx,
+		"SELECT id, name, email FROM users WHERE id = $1",
+		id,
+	).Scan(&u.ID, &u.Name, &u.Email)
+
+	if err == sql.ErrNoRows {
+		return User{}, ErrNotFound
+	}
+	if err != nil {
+		return User{}, err
+	}
+	return u, nil
+}
+
+func (r *PostgresRepository) Create(ctx context.Context, name, email string) (User, error) {
+	var u User
+	err := r.db.QueryRowContext(ctx,
+		"INSERT INTO users (name, email) VALUES ($1, $2) RETURNING id, name, email",
+		name, email,
+	).Scan(&u.ID, &u.Name, &u.Email)
+	if err != nil {
+		return User{}, err
+	}
+	return u, nil
+}
+
+func (r *PostgresRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM users WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+// Package users defines the User type and the Repository interface
+// used to store and retrieve it, independent of any storage backend.
+package users
+
+import (
+	"context"
+	"errors"
+)
+
+// User is a registered user of the system.
+type User struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// ErrNotFound is returned by Repository methods when the requested user
+// does not exist.
+var ErrNotFound = errors.New("user not found")
+
+// Repository stores and retrieves users.
+type Repository interface {
+	List(ctx context.Context, params ListParams) (Page, error)
+	Get(ctx context.Context, id int) (User, error)
+	Create(ctx context.Context, name, email string) (User, error)
+	Delete(ctx context.Context, id int) error
+}